@@ -0,0 +1,176 @@
+// Package config 负责加载应用配置。
+// 加载顺序为: 配置文件 -> 环境变量 -> 命令行参数，后者会覆盖前者的同名字段。
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ServerConfig 对应 [server] 配置段
+type ServerConfig struct {
+	Port         int           `toml:"port"`
+	GRPCPort     int           `toml:"grpc_port"`
+	ReadTimeout  time.Duration `toml:"read_timeout"`
+	WriteTimeout time.Duration `toml:"write_timeout"`
+	TLSCertFile  string        `toml:"tls_cert_file"`
+	TLSKeyFile   string        `toml:"tls_key_file"`
+}
+
+// DatabaseConfig 对应 [database] 配置段
+type DatabaseConfig struct {
+	User            string        `toml:"user"`
+	Pass            string        `toml:"pass"`
+	Host            string        `toml:"host"`
+	Port            int           `toml:"port"`
+	Name            string        `toml:"name"`
+	MaxIdleConns    int           `toml:"max_idle_conns"`
+	MaxOpenConns    int           `toml:"max_open_conns"`
+	ConnMaxLifetime time.Duration `toml:"conn_max_lifetime"`
+}
+
+// LoggingConfig 对应 [logging] 配置段
+type LoggingConfig struct {
+	Level  string `toml:"level"`
+	Format string `toml:"format"`
+}
+
+// AuthConfig 对应 [auth] 配置段，控制 JWT 的签名密钥与有效期
+type AuthConfig struct {
+	JWTSecret string        `toml:"jwt_secret"`
+	JWTTTL    time.Duration `toml:"jwt_ttl"`
+}
+
+// Config 是应用的完整配置
+type Config struct {
+	Server   ServerConfig   `toml:"server"`
+	Database DatabaseConfig `toml:"database"`
+	Logging  LoggingConfig  `toml:"logging"`
+	Auth     AuthConfig     `toml:"auth"`
+}
+
+// DSN 拼出 database/sql 可用的 MySQL 连接字符串
+func (c DatabaseConfig) DSN() string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", c.User, c.Pass, c.Host, c.Port, c.Name)
+}
+
+// defaults 返回在配置文件和环境变量都缺省时使用的兜底值
+func defaults() Config {
+	return Config{
+		Server: ServerConfig{
+			Port:         8080,
+			GRPCPort:     9090,
+			ReadTimeout:  10 * time.Second,
+			WriteTimeout: 10 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Host:            "127.0.0.1",
+			Port:            3306,
+			MaxIdleConns:    10,
+			MaxOpenConns:    100,
+			ConnMaxLifetime: time.Hour,
+		},
+		Logging: LoggingConfig{
+			Level:  "info",
+			Format: "text",
+		},
+		Auth: AuthConfig{
+			JWTTTL: 24 * time.Hour,
+		},
+	}
+}
+
+// Load 按照 文件 -> 环境变量 -> 命令行参数 的顺序解析配置，并在返回前校验必填字段。
+// path 为 TOML 配置文件路径；若为空字符串则跳过文件加载，仅使用默认值、环境变量与命令行参数。
+func Load(path string) (*Config, error) {
+	cfg := defaults()
+
+	if path != "" {
+		if _, err := toml.DecodeFile(path, &cfg); err != nil {
+			return nil, fmt.Errorf("加载配置文件 %q 失败: %w", path, err)
+		}
+	}
+
+	applyEnvOverrides(&cfg)
+	applyFlagOverrides(&cfg)
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// applyEnvOverrides 用环境变量覆盖配置文件中的同名字段，环境变量缺失时保持原值不变
+func applyEnvOverrides(cfg *Config) {
+	if v := os.Getenv("WTT_SERVER_PORT"); v != "" {
+		if port, err := strconv.Atoi(v); err == nil {
+			cfg.Server.Port = port
+		}
+	}
+	if v := os.Getenv("WTT_DB_USER"); v != "" {
+		cfg.Database.User = v
+	}
+	if v := os.Getenv("WTT_DB_PASS"); v != "" {
+		cfg.Database.Pass = v
+	}
+	if v := os.Getenv("WTT_DB_HOST"); v != "" {
+		cfg.Database.Host = v
+	}
+	if v := os.Getenv("WTT_DB_PORT"); v != "" {
+		if port, err := strconv.Atoi(v); err == nil {
+			cfg.Database.Port = port
+		}
+	}
+	if v := os.Getenv("WTT_DB_NAME"); v != "" {
+		cfg.Database.Name = v
+	}
+	if v := os.Getenv("WTT_LOG_LEVEL"); v != "" {
+		cfg.Logging.Level = v
+	}
+	if v := os.Getenv("WTT_JWT_SECRET"); v != "" {
+		cfg.Auth.JWTSecret = v
+	}
+}
+
+// applyFlagOverrides 用命令行参数覆盖配置文件/环境变量中的同名字段。
+// 只有显式传入的参数才会生效，未传入的参数保留之前解析出的值。
+func applyFlagOverrides(cfg *Config) {
+	if flag.Parsed() {
+		// 避免在测试或被其他调用方重复解析时 panic
+		return
+	}
+
+	port := flag.Int("server-port", cfg.Server.Port, "HTTP 服务监听端口")
+	dbHost := flag.String("db-host", cfg.Database.Host, "数据库主机地址")
+	dbName := flag.String("db-name", cfg.Database.Name, "数据库名")
+	flag.Parse()
+
+	cfg.Server.Port = *port
+	cfg.Database.Host = *dbHost
+	cfg.Database.Name = *dbName
+}
+
+// validate 检查启动所必需的字段是否齐全
+func (c Config) validate() error {
+	if c.Database.User == "" {
+		return fmt.Errorf("config: database.user 不能为空")
+	}
+	if c.Database.Host == "" {
+		return fmt.Errorf("config: database.host 不能为空")
+	}
+	if c.Database.Name == "" {
+		return fmt.Errorf("config: database.name 不能为空")
+	}
+	if c.Server.Port <= 0 {
+		return fmt.Errorf("config: server.port 必须为正整数")
+	}
+	if c.Auth.JWTSecret == "" {
+		return fmt.Errorf("config: auth.jwt_secret 不能为空")
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Package db 负责建立底层数据库连接、配置连接池并执行启动时的自动迁移。
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/config"
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+)
+
+// NewConnection 根据配置建立 GORM/MySQL 连接，设置连接池参数，并执行自动迁移
+func NewConnection(cfg config.DatabaseConfig) (*gorm.DB, error) {
+	// TranslateError 让 GORM 把驱动特定的错误 (如 MySQL 1062 重复键) 翻译成 gorm.ErrDuplicatedKey 等标准错误，
+	// repository 层的 errors.Is(err, gorm.ErrDuplicatedKey) 判断依赖这个开关
+	gdb, err := gorm.Open(mysql.Open(cfg.DSN()), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, fmt.Errorf("db: 连接数据库失败: %w", err)
+	}
+
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, fmt.Errorf("db: 获取底层 *sql.DB 失败: %w", err)
+	}
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	if err := gdb.AutoMigrate(&repository.Task{}, &repository.User{}); err != nil {
+		return nil, fmt.Errorf("db: 自动迁移失败: %w", err)
+	}
+
+	return gdb, nil
+}
@@ -0,0 +1,63 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/middleware"
+)
+
+// userIDContextKey 是把 JWT 校验出的 user_id 写入 context 时使用的 key 类型，
+// 避免和标准库/其它包放进 context 的 key 冲突
+type userIDContextKey struct{}
+
+// AuthUnaryInterceptor 返回一个校验 `authorization: Bearer <token>` gRPC 元数据的一元拦截器，
+// 校验规则和 middleware.RequireAuth 完全一致(共用 middleware.ParseToken)，
+// 校验通过后把 token 中的 user_id 写入 context，TaskServer 的各个 RPC 只信任这个 user_id，
+// 不再信任请求消息里客户端自己填的 user_id 字段，从而和 REST 一样按认证主体做用户隔离
+func AuthUnaryInterceptor(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		userID, err := userIDFromContext(ctx, secret)
+		if err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, userIDContextKey{}, userID), req)
+	}
+}
+
+func userIDFromContext(ctx context.Context, secret string) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "缺少认证元数据")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return 0, status.Error(codes.Unauthenticated, "缺少或格式错误的 authorization 元数据")
+	}
+	tokenString, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok || tokenString == "" {
+		return 0, status.Error(codes.Unauthenticated, "缺少或格式错误的 authorization 元数据")
+	}
+
+	userID, err := middleware.ParseToken(secret, tokenString)
+	if err != nil {
+		return 0, status.Error(codes.Unauthenticated, "无效或已过期的 token")
+	}
+	return userID, nil
+}
+
+// authenticatedUserID 读取由 AuthUnaryInterceptor 写入 context 的 user_id，
+// 各 RPC 方法以此代替请求消息中客户端自行填写的 user_id 字段
+func authenticatedUserID(ctx context.Context) (int64, error) {
+	userID, ok := ctx.Value(userIDContextKey{}).(int64)
+	if !ok {
+		return 0, status.Error(codes.Internal, "context 中缺少认证信息，拦截器未生效")
+	}
+	return userID, nil
+}
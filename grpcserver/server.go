@@ -0,0 +1,121 @@
+// Package grpcserver 把 proto/task/task.proto 定义的 TaskService 接到 service.TaskService 上，
+// 与 handler 包一样，只是同一套业务逻辑的另一种传输层，行为必须与 REST 版本保持一致。
+// taskpb 由 `make proto` 从 proto/task/task.proto 生成，生成产物已提交在 proto/task/*.pb.go，
+// 修改 .proto 后需要重新生成并一并提交，保证仓库不依赖本地 protoc 环境即可构建。
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+	"github.com/Alchemist-xin/weekly-task-tracker/service"
+
+	taskpb "github.com/Alchemist-xin/weekly-task-tracker/proto/task"
+)
+
+// TaskServer 实现 taskpb.TaskServiceServer，委托给 service.TaskService 完成实际工作
+type TaskServer struct {
+	taskpb.UnimplementedTaskServiceServer
+	svc *service.TaskService
+}
+
+// NewTaskServer 用一个 TaskService 构造 gRPC TaskServer
+func NewTaskServer(svc *service.TaskService) *TaskServer {
+	return &TaskServer{svc: svc}
+}
+
+func (s *TaskServer) Create(ctx context.Context, req *taskpb.CreateTaskRequest) (*taskpb.Task, error) {
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, err := s.svc.CreateTask(ctx, userID, req.GetDescription(), req.GetRecurrenceRule())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "创建任务失败: %v", err)
+	}
+	return toProtoTask(task), nil
+}
+
+func (s *TaskServer) Get(ctx context.Context, req *taskpb.GetTaskRequest) (*taskpb.Task, error) {
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, err := s.svc.GetTask(ctx, req.GetId(), userID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoTask(task), nil
+}
+
+func (s *TaskServer) List(ctx context.Context, req *taskpb.ListTasksRequest) (*taskpb.ListTasksResponse, error) {
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.svc.ListTasksForWeek(ctx, userID, req.GetWeekIdentifier())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "获取任务列表失败: %v", err)
+	}
+
+	resp := &taskpb.ListTasksResponse{Tasks: make([]*taskpb.Task, 0, len(tasks))}
+	for i := range tasks {
+		resp.Tasks = append(resp.Tasks, toProtoTask(&tasks[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) UpdateStatus(ctx context.Context, req *taskpb.UpdateTaskStatusRequest) (*taskpb.Task, error) {
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.UpdateTaskStatus(ctx, req.GetId(), userID, req.GetStatus()); err != nil {
+		return nil, toStatusError(err)
+	}
+	task, err := s.svc.GetTask(ctx, req.GetId(), userID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoTask(task), nil
+}
+
+func (s *TaskServer) Delete(ctx context.Context, req *taskpb.DeleteTaskRequest) (*taskpb.DeleteTaskResponse, error) {
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.DeleteTask(ctx, req.GetId(), userID); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &taskpb.DeleteTaskResponse{Deleted: true}, nil
+}
+
+func toStatusError(err error) error {
+	if errors.Is(err, repository.ErrTaskNotFound) {
+		return status.Error(codes.NotFound, "任务不存在")
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+func toProtoTask(task *repository.Task) *taskpb.Task {
+	pbTask := &taskpb.Task{
+		Id:             task.ID,
+		UserId:         task.UserID,
+		Description:    task.Description,
+		WeekIdentifier: task.WeekIdentifier,
+		Status:         task.Status,
+		RecurrenceRule: task.RecurrenceRule,
+		CreatedAt:      timestamppb.New(task.CreatedAt),
+		UpdatedAt:      timestamppb.New(task.UpdatedAt),
+	}
+	if task.ParentTaskID != nil {
+		pbTask.ParentTaskId = task.ParentTaskID
+	}
+	return pbTask
+}
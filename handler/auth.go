@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+	"github.com/Alchemist-xin/weekly-task-tracker/service"
+)
+
+// AuthHandler 把 /auth/* 请求翻译成对 service.AuthService 的调用
+type AuthHandler struct {
+	svc *service.AuthService
+}
+
+// NewAuthHandler 用一个 AuthService 构造认证 handler
+func NewAuthHandler(svc *service.AuthService) *AuthHandler {
+	return &AuthHandler{svc: svc}
+}
+
+// RegisterRoutes 把 /auth/register 和 /auth/login 挂到传入的路由组上
+func (h *AuthHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/register", h.Register)
+	rg.POST("/login", h.Login)
+}
+
+type credentialsRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+// Register 处理注册请求 (POST /auth/register)
+func (h *AuthHandler) Register(c *gin.Context) {
+	var requestBody credentialsRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求: " + err.Error()})
+		return
+	}
+
+	user, err := h.svc.Register(c.Request.Context(), requestBody.Username, requestBody.Password)
+	if err != nil {
+		if errors.Is(err, repository.ErrUsernameTaken) {
+			c.JSON(http.StatusConflict, gin.H{"error": "用户名已被占用"})
+			return
+		}
+		log.Printf("注册用户时出错: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "注册失败"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"message": "注册成功", "user_id": user.ID, "username": user.Username})
+}
+
+// Login 处理登录请求 (POST /auth/login)，成功后返回 JWT
+func (h *AuthHandler) Login(c *gin.Context) {
+	var requestBody credentialsRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求: " + err.Error()})
+		return
+	}
+
+	token, err := h.svc.Login(c.Request.Context(), requestBody.Username, requestBody.Password)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "用户名或密码错误"})
+			return
+		}
+		log.Printf("登录时出错: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "登录失败"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token})
+}
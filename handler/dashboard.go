@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/service"
+)
+
+// DashboardHandler 提供 GET /dashboard 的周维度统计看板
+type DashboardHandler struct {
+	svc *service.TaskService
+}
+
+// NewDashboardHandler 用一个 TaskService 构造看板 handler
+func NewDashboardHandler(svc *service.TaskService) *DashboardHandler {
+	return &DashboardHandler{svc: svc}
+}
+
+// Get 处理看板请求 (GET /dashboard?weeks=N)，weeks 默认为 8。
+// 响应带有根据最近更新时间计算出的 ETag，客户端可以用 If-None-Match 做廉价的条件请求缓存。
+func (h *DashboardHandler) Get(c *gin.Context) {
+	weeks := 0
+	if raw := c.Query("weeks"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "weeks 必须是正整数"})
+			return
+		}
+		weeks = parsed
+	}
+
+	dashboard, err := h.svc.GetDashboard(c.Request.Context(), currentUserID(c), weeks)
+	if err != nil {
+		log.Printf("获取看板数据时出错: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取看板数据失败"})
+		return
+	}
+
+	if dashboard.ETag != "" {
+		c.Header("ETag", dashboard.ETag)
+		if match := c.GetHeader("If-None-Match"); match == dashboard.ETag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, dashboard)
+}
@@ -0,0 +1,210 @@
+// Package handler 把 HTTP 请求翻译成对 service.TaskService 的调用，
+// 不直接触碰数据库，所有持久化细节都下沉在 repository/service 两层。
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+	"github.com/Alchemist-xin/weekly-task-tracker/service"
+)
+
+// TaskHandler 持有任务服务，并暴露一组绑定到 gin 路由的方法
+type TaskHandler struct {
+	svc *service.TaskService
+}
+
+// NewTaskHandler 用一个 TaskService 构造任务 handler
+func NewTaskHandler(svc *service.TaskService) *TaskHandler {
+	return &TaskHandler{svc: svc}
+}
+
+// RegisterRoutes 把所有任务相关的路由挂到传入的路由组上
+func (h *TaskHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/", h.Create)
+	rg.GET("/", h.List)
+	rg.PUT("/:id", h.Update)
+	rg.PATCH("/:id/status", h.UpdateStatus)
+	rg.DELETE("/:id", h.Delete)
+	rg.GET("/carryover", h.PreviewCarryover)
+	rg.POST("/carryover", h.ApplyCarryover)
+}
+
+// Create 处理创建新任务的请求 (POST /tasks)
+func (h *TaskHandler) Create(c *gin.Context) {
+	var requestBody struct {
+		Description    string `json:"description" binding:"required"`
+		RecurrenceRule string `json:"recurrence_rule"`
+	}
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求: " + err.Error()})
+		return
+	}
+
+	userID := currentUserID(c)
+	task, err := h.svc.CreateTask(c.Request.Context(), userID, requestBody.Description, requestBody.RecurrenceRule)
+	if err != nil {
+		log.Printf("创建任务时数据库错误: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "创建任务失败"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, task)
+}
+
+// List 处理获取任务列表的请求 (GET /tasks)，可通过 ?week=YYYY-WW 指定周，否则默认为当前周
+func (h *TaskHandler) List(c *gin.Context) {
+	userID := currentUserID(c)
+	tasks, err := h.svc.ListTasksForWeek(c.Request.Context(), userID, c.Query("week"))
+	if err != nil {
+		log.Printf("查询任务时数据库错误: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取任务列表失败"})
+		return
+	}
+	c.JSON(http.StatusOK, tasks)
+}
+
+// Update 处理更新任务内容的请求 (PUT /tasks/:id)
+func (h *TaskHandler) Update(c *gin.Context) {
+	id, err := parseTaskID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的任务 ID"})
+		return
+	}
+
+	var requestBody struct {
+		Description    string `json:"description" binding:"required"`
+		RecurrenceRule string `json:"recurrence_rule"`
+	}
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求: " + err.Error()})
+		return
+	}
+
+	userID := currentUserID(c)
+	if err := h.svc.UpdateTask(c.Request.Context(), id, userID, requestBody.Description, requestBody.RecurrenceRule); err != nil {
+		respondRepositoryError(c, "更新任务失败", id, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "任务更新成功", "task_id": id})
+}
+
+// UpdateStatus 处理更新任务状态的请求 (PATCH /tasks/:id/status)
+func (h *TaskHandler) UpdateStatus(c *gin.Context) {
+	id, err := parseTaskID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的任务 ID"})
+		return
+	}
+
+	var requestBody struct {
+		Status string `json:"status" binding:"required,oneof=pending completed"`
+	}
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求: " + err.Error()})
+		return
+	}
+
+	userID := currentUserID(c)
+	if err := h.svc.UpdateTaskStatus(c.Request.Context(), id, userID, requestBody.Status); err != nil {
+		respondRepositoryError(c, "更新任务状态失败", id, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "任务状态更新成功", "task_id": id, "status": requestBody.Status})
+}
+
+// Delete 处理删除任务的请求 (DELETE /tasks/:id)
+func (h *TaskHandler) Delete(c *gin.Context) {
+	id, err := parseTaskID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的任务 ID"})
+		return
+	}
+
+	userID := currentUserID(c)
+	if err := h.svc.DeleteTask(c.Request.Context(), id, userID); err != nil {
+		respondRepositoryError(c, "删除任务失败", id, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "任务删除成功", "task_id": id})
+}
+
+// PreviewCarryover 处理顺延预览请求 (GET /tasks/carryover?from=YYYY-Www&to=YYYY-Www)
+func (h *TaskHandler) PreviewCarryover(c *gin.Context) {
+	from := c.Query("from")
+	to := c.Query("to")
+	if from == "" || to == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "必须提供 from 和 to 查询参数"})
+		return
+	}
+	if _, _, err := service.ParseWeekIdentifier(from); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if _, _, err := service.ParseWeekIdentifier(to); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	candidates, err := h.svc.PreviewCarryover(c.Request.Context(), currentUserID(c), from)
+	if err != nil {
+		log.Printf("预览顺延任务时出错: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "预览顺延任务失败"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"from": from, "to": to, "tasks": candidates})
+}
+
+// ApplyCarryover 处理应用顺延请求 (POST /tasks/carryover)，请求体: {"from": "YYYY-Www", "to": "YYYY-Www"}
+func (h *TaskHandler) ApplyCarryover(c *gin.Context) {
+	var requestBody struct {
+		From string `json:"from" binding:"required"`
+		To   string `json:"to" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的请求: " + err.Error()})
+		return
+	}
+	if _, _, err := service.ParseWeekIdentifier(requestBody.From); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if _, _, err := service.ParseWeekIdentifier(requestBody.To); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := h.svc.ApplyCarryover(c.Request.Context(), currentUserID(c), requestBody.From, requestBody.To)
+	if err != nil {
+		log.Printf("应用顺延时出错: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "应用顺延失败"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "顺延完成", "from": requestBody.From, "to": requestBody.To, "created": created})
+}
+
+func parseTaskID(c *gin.Context) (int64, error) {
+	return strconv.ParseInt(c.Param("id"), 10, 64)
+}
+
+// currentUserID 读取由 middleware.RequireAuth 写入的认证主体 ID
+func currentUserID(c *gin.Context) int64 {
+	return c.GetInt64("user_id")
+}
+
+// respondRepositoryError 把仓储层的错误翻译成合适的 HTTP 状态码
+func respondRepositoryError(c *gin.Context, message string, id int64, err error) {
+	if errors.Is(err, repository.ErrTaskNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "任务不存在"})
+		return
+	}
+	log.Printf("%s (task_id=%d): %v", message, id, err)
+	c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+}
@@ -0,0 +1,118 @@
+// Package integration 验证 REST 和 gRPC 这两条传输链路在共享同一个
+// repository/service 时行为保持一致（chunk0-5 要求的"行为对等"承诺）。
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/grpcserver"
+	"github.com/Alchemist-xin/weekly-task-tracker/handler"
+	"github.com/Alchemist-xin/weekly-task-tracker/middleware"
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+	"github.com/Alchemist-xin/weekly-task-tracker/service"
+
+	taskpb "github.com/Alchemist-xin/weekly-task-tracker/proto/task"
+)
+
+const testUserID int64 = 1
+const testJWTSecret = "integration-test-secret"
+
+// withTestUser 直接把固定的 user_id 写入 gin.Context，代替真实的 middleware.RequireAuth，
+// 让这个测试专注于验证 REST/gRPC 的行为是否一致，而不必签发真实的 JWT
+func withTestUser(c *gin.Context) {
+	c.Set("user_id", testUserID)
+	c.Next()
+}
+
+// newTestService 用内存 SQLite 搭出一个真实的 repository/service，REST 和 gRPC 两端共享同一个实例
+func newTestService(t *testing.T) *service.TaskService {
+	t.Helper()
+	gdb, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("打开内存 SQLite 失败: %v", err)
+	}
+	if err := gdb.AutoMigrate(&repository.Task{}, &repository.User{}); err != nil {
+		t.Fatalf("自动迁移失败: %v", err)
+	}
+	return service.NewTaskService(repository.NewTaskRepository(gdb))
+}
+
+// TestRESTAndGRPCParity 通过 REST 创建一个任务，再分别用 REST 列表接口和 gRPC Get 读取，
+// 断言两条传输链路返回的数据一致，符合 chunk0-5 "REST 和 gRPC 行为保持一致" 的要求
+func TestRESTAndGRPCParity(t *testing.T) {
+	svc := newTestService(t)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	taskRoutes := router.Group("/tasks")
+	taskRoutes.Use(withTestUser)
+	handler.NewTaskHandler(svc).RegisterRoutes(taskRoutes)
+	restServer := httptest.NewServer(router)
+	defer restServer.Close()
+
+	resp, err := http.Post(restServer.URL+"/tasks/", "application/json", strings.NewReader(`{"description":"写周报"}`))
+	if err != nil {
+		t.Fatalf("REST 创建任务失败: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("REST 创建任务返回非预期状态码: %d", resp.StatusCode)
+	}
+	var created repository.Task
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("解析 REST 响应失败: %v", err)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcserver.AuthUnaryInterceptor(testJWTSecret)))
+	taskpb.RegisterTaskServiceServer(grpcServer, grpcserver.NewTaskServer(svc))
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("连接 gRPC 服务失败: %v", err)
+	}
+	defer conn.Close()
+
+	token, err := middleware.GenerateToken(testJWTSecret, time.Minute, testUserID)
+	if err != nil {
+		t.Fatalf("签发测试 token 失败: %v", err)
+	}
+	grpcCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	got, err := taskpb.NewTaskServiceClient(conn).Get(grpcCtx, &taskpb.GetTaskRequest{Id: created.ID})
+	if err != nil {
+		t.Fatalf("gRPC 获取任务失败: %v", err)
+	}
+
+	if got.GetDescription() != created.Description {
+		t.Fatalf("REST 和 gRPC 返回的任务描述不一致: rest=%q grpc=%q", created.Description, got.GetDescription())
+	}
+	if got.GetStatus() != created.Status {
+		t.Fatalf("REST 和 gRPC 返回的任务状态不一致: rest=%q grpc=%q", created.Status, got.GetStatus())
+	}
+	if got.GetWeekIdentifier() != created.WeekIdentifier {
+		t.Fatalf("REST 和 gRPC 返回的周标识符不一致: rest=%q grpc=%q", created.WeekIdentifier, got.GetWeekIdentifier())
+	}
+}
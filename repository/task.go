@@ -0,0 +1,189 @@
+// Package repository 封装对 Task 的持久化访问，上层通过 TaskRepository 接口依赖它，
+// 从而可以在测试中替换为内存/SQLite 实现，而不必依赖真实的 MySQL。
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrTaskNotFound 表示按 ID 查找/更新/删除任务时没有命中任何记录
+var ErrTaskNotFound = errors.New("repository: task not found")
+
+// Task 是任务的 GORM 模型，字段与原先 database/sql 版本保持一致，新增 GORM 标签用于建表与自动维护时间戳
+type Task struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID         int64     `gorm:"column:user_id;index;not null" json:"user_id"`
+	Description    string    `gorm:"column:description;not null" json:"description"`
+	WeekIdentifier string    `gorm:"column:week_identifier;index;not null" json:"week_identifier"`
+	Status         string    `gorm:"column:status;not null;default:pending" json:"status"`
+	RecurrenceRule string    `gorm:"column:recurrence_rule;not null;default:none" json:"recurrence_rule"`
+	ParentTaskID   *int64    `gorm:"column:parent_task_id" json:"parent_task_id,omitempty"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 固定表名，避免 GORM 默认的复数规则推断出不一致的名字
+func (Task) TableName() string {
+	return "tasks"
+}
+
+// TaskRepository 是 Task 持久化层的抽象，handler/service 只依赖这个接口，
+// 真实环境下由 gormTaskRepository 实现，测试中可以换成内存/SQLite 实现
+type TaskRepository interface {
+	Create(ctx context.Context, task *Task) error
+	GetByID(ctx context.Context, id, userID int64) (*Task, error)
+	ListByWeekAndUser(ctx context.Context, weekIdentifier string, userID int64) ([]Task, error)
+	Update(ctx context.Context, id, userID int64, description, recurrenceRule string) error
+	UpdateStatus(ctx context.Context, id, userID int64, status string) error
+	Delete(ctx context.Context, id, userID int64) error
+	ListCarryoverCandidates(ctx context.Context, weekIdentifier string, userID int64) ([]Task, error)
+	ListAllCarryoverCandidates(ctx context.Context, weekIdentifier string) ([]Task, error)
+	ExistingCarryoverChildren(ctx context.Context, weekIdentifier string, parentIDs []int64) (map[int64]bool, error)
+	WeeklyStats(ctx context.Context, userID int64, limitWeeks int) ([]WeeklyStat, error)
+}
+
+// WeeklyStat 是按周聚合后的任务统计，由一次 GROUP BY 查询直接产出，
+// 看板(dashboard)在此基础上于 Go 侧计算完成率、移动平均和连续周数
+type WeeklyStat struct {
+	WeekIdentifier string    `gorm:"column:week_identifier"`
+	Total          int64     `gorm:"column:total"`
+	Completed      int64     `gorm:"column:completed"`
+	Pending        int64     `gorm:"column:pending"`
+	MaxUpdatedAt   time.Time `gorm:"column:max_updated_at"`
+}
+
+// gormTaskRepository 是 TaskRepository 基于 GORM + MySQL 的实现
+type gormTaskRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskRepository 用一个已经建立好连接池的 *gorm.DB 构造仓储实例
+func NewTaskRepository(db *gorm.DB) TaskRepository {
+	return &gormTaskRepository{db: db}
+}
+
+func (r *gormTaskRepository) Create(ctx context.Context, task *Task) error {
+	return r.db.WithContext(ctx).Create(task).Error
+}
+
+func (r *gormTaskRepository) GetByID(ctx context.Context, id, userID int64) (*Task, error) {
+	var task Task
+	err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&task).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (r *gormTaskRepository) ListByWeekAndUser(ctx context.Context, weekIdentifier string, userID int64) ([]Task, error) {
+	var tasks []Task
+	err := r.db.WithContext(ctx).
+		Where("week_identifier = ? AND user_id = ?", weekIdentifier, userID).
+		Order("created_at DESC").
+		Find(&tasks).Error
+	return tasks, err
+}
+
+func (r *gormTaskRepository) Update(ctx context.Context, id, userID int64, description, recurrenceRule string) error {
+	result := r.db.WithContext(ctx).Model(&Task{}).Where("id = ? AND user_id = ?", id, userID).Updates(map[string]interface{}{
+		"description":     description,
+		"recurrence_rule": recurrenceRule,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+func (r *gormTaskRepository) UpdateStatus(ctx context.Context, id, userID int64, status string) error {
+	result := r.db.WithContext(ctx).Model(&Task{}).Where("id = ? AND user_id = ?", id, userID).Update("status", status)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+func (r *gormTaskRepository) Delete(ctx context.Context, id, userID int64) error {
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&Task{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+// ListCarryoverCandidates 返回某个用户在某一周中仍为 pending，或设置了重复规则的任务，
+// 这些任务是顺延(carry-over)到下一周的候选。按 user_id 过滤是必须的——调用方通常是认证用户本人发起的预览/应用请求。
+func (r *gormTaskRepository) ListCarryoverCandidates(ctx context.Context, weekIdentifier string, userID int64) ([]Task, error) {
+	var tasks []Task
+	err := r.db.WithContext(ctx).
+		Where("week_identifier = ? AND user_id = ? AND (status = ? OR recurrence_rule <> ?)", weekIdentifier, userID, "pending", "none").
+		Find(&tasks).Error
+	return tasks, err
+}
+
+// ListAllCarryoverCandidates 和 ListCarryoverCandidates 规则相同，但不按用户过滤，
+// 仅供后台的周切换调度器使用——它需要一次性为所有用户物化顺延任务
+func (r *gormTaskRepository) ListAllCarryoverCandidates(ctx context.Context, weekIdentifier string) ([]Task, error) {
+	var tasks []Task
+	err := r.db.WithContext(ctx).
+		Where("week_identifier = ? AND (status = ? OR recurrence_rule <> ?)", weekIdentifier, "pending", "none").
+		Find(&tasks).Error
+	return tasks, err
+}
+
+// ExistingCarryoverChildren 返回 parentIDs 中，已经在 weekIdentifier 周存在顺延子任务的那些任务 ID，
+// 用于在物化顺延前去重，避免重复调用（比如预览/应用接口被重复提交，或与后台调度器重叠）产生重复任务
+func (r *gormTaskRepository) ExistingCarryoverChildren(ctx context.Context, weekIdentifier string, parentIDs []int64) (map[int64]bool, error) {
+	existing := make(map[int64]bool, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return existing, nil
+	}
+
+	var parents []int64
+	err := r.db.WithContext(ctx).Model(&Task{}).
+		Where("week_identifier = ? AND parent_task_id IN ?", weekIdentifier, parentIDs).
+		Pluck("parent_task_id", &parents).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range parents {
+		existing[id] = true
+	}
+	return existing, nil
+}
+
+// WeeklyStats 用一条 GROUP BY week_identifier 的查询返回每周的总数/完成数/待办数以及该周内最近一次更新时间，
+// 按 week_identifier 倒序只取最近 limitWeeks 周，保证这里的数据库开销是 O(weeks) 而不是 O(tasks)
+func (r *gormTaskRepository) WeeklyStats(ctx context.Context, userID int64, limitWeeks int) ([]WeeklyStat, error) {
+	var stats []WeeklyStat
+	err := r.db.WithContext(ctx).Model(&Task{}).
+		Select(
+			"week_identifier",
+			"COUNT(*) AS total",
+			"SUM(CASE WHEN status = 'completed' THEN 1 ELSE 0 END) AS completed",
+			"SUM(CASE WHEN status = 'pending' THEN 1 ELSE 0 END) AS pending",
+			"MAX(updated_at) AS max_updated_at",
+		).
+		Where("user_id = ?", userID).
+		Group("week_identifier").
+		Order("week_identifier DESC").
+		Limit(limitWeeks).
+		Scan(&stats).Error
+	return stats, err
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrUserNotFound 表示按用户名/ID 查找用户时没有命中任何记录
+var ErrUserNotFound = errors.New("repository: user not found")
+
+// ErrUsernameTaken 表示注册时用户名已经存在
+var ErrUsernameTaken = errors.New("repository: username already taken")
+
+// User 是用户账号的 GORM 模型
+type User struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Username     string    `gorm:"column:username;uniqueIndex;not null" json:"username"`
+	PasswordHash string    `gorm:"column:password_hash;not null" json:"-"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 固定表名，避免 GORM 默认的复数规则推断出不一致的名字
+func (User) TableName() string {
+	return "users"
+}
+
+// UserRepository 是用户持久化层的抽象
+type UserRepository interface {
+	Create(ctx context.Context, user *User) error
+	GetByUsername(ctx context.Context, username string) (*User, error)
+}
+
+type gormUserRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository 用一个已经建立好连接池的 *gorm.DB 构造用户仓储实例
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &gormUserRepository{db: db}
+}
+
+func (r *gormUserRepository) Create(ctx context.Context, user *User) error {
+	if err := r.db.WithContext(ctx).Create(user).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrUsernameTaken
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *gormUserRepository) GetByUsername(ctx context.Context, username string) (*User, error) {
+	var user User
+	err := r.db.WithContext(ctx).Where("username = ?", username).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
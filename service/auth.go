@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/middleware"
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+)
+
+// ErrInvalidCredentials 表示登录时用户名不存在或密码不匹配
+var ErrInvalidCredentials = errors.New("service: invalid username or password")
+
+// AuthService 处理用户注册/登录，并签发 JWT
+type AuthService struct {
+	repo      repository.UserRepository
+	jwtSecret string
+	jwtTTL    time.Duration
+}
+
+// NewAuthService 用用户仓储以及 JWT 签名配置构造认证服务
+func NewAuthService(repo repository.UserRepository, jwtSecret string, jwtTTL time.Duration) *AuthService {
+	return &AuthService{repo: repo, jwtSecret: jwtSecret, jwtTTL: jwtTTL}
+}
+
+// Register 创建一个新用户，密码以 bcrypt 哈希后存储
+func (s *AuthService) Register(ctx context.Context, username, password string) (*repository.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &repository.User{Username: username, PasswordHash: string(hash)}
+	if err := s.repo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Login 校验用户名/密码，成功后返回签发的 JWT
+func (s *AuthService) Login(ctx context.Context, username, password string) (string, error) {
+	user, err := s.repo.GetByUsername(ctx, username)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return "", ErrInvalidCredentials
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	return middleware.GenerateToken(s.jwtSecret, s.jwtTTL, user.ID)
+}
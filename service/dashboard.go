@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+)
+
+// WeekStat 是看板中单独一周的统计数据，在 repository.WeeklyStat 的基础上
+// 补充了完成率和滚动 4 周移动平均，这两者都在 Go 侧计算，数据库只负责 GROUP BY 聚合
+type WeekStat struct {
+	WeekIdentifier     string  `json:"week_identifier"`
+	Total              int64   `json:"total"`
+	Completed          int64   `json:"completed"`
+	Pending            int64   `json:"pending"`
+	CompletionRate     float64 `json:"completion_rate"`
+	MovingAvgRate4Week float64 `json:"moving_avg_completion_rate_4w"`
+}
+
+// Dashboard 是 GET /dashboard 的完整响应
+type Dashboard struct {
+	Weeks         []WeekStat `json:"weeks"`
+	CurrentStreak int        `json:"current_streak"`
+	ETag          string     `json:"-"`
+}
+
+const defaultDashboardWeeks = 8
+const movingAverageWindow = 4
+
+// GetDashboard 汇总最近 weeks 周的任务完成情况。数据库只做一次 GROUP BY 聚合查询，
+// 完成率、移动平均和连续周数都在 Go 侧基于聚合结果计算，因此数据库开销是 O(weeks)。
+func (s *TaskService) GetDashboard(ctx context.Context, userID int64, weeks int) (*Dashboard, error) {
+	if weeks <= 0 {
+		weeks = defaultDashboardWeeks
+	}
+
+	rawStats, err := s.repo.WeeklyStats(ctx, userID, weeks)
+	if err != nil {
+		return nil, err
+	}
+
+	// WeeklyStats 按 week_identifier 倒序返回 (最新的周在前)，反转成从旧到新，方便按时间顺序计算移动平均和连续周数
+	reverseWeeklyStats(rawStats)
+
+	// WeeklyStats 只会返回有任务行的周，一周完全没有任务就不会出现在结果里。
+	// 如果不补齐，连续周数和移动平均会把"中间空了一周"当成紧邻的两周来算，需要先把完整的连续周区间补零
+	rawStats, err = fillMissingWeeks(rawStats)
+	if err != nil {
+		return nil, err
+	}
+
+	weekStats := make([]WeekStat, len(rawStats))
+	var maxUpdatedAt time.Time
+	for i, raw := range rawStats {
+		completionRate := 0.0
+		if raw.Total > 0 {
+			completionRate = float64(raw.Completed) / float64(raw.Total)
+		}
+		weekStats[i] = WeekStat{
+			WeekIdentifier: raw.WeekIdentifier,
+			Total:          raw.Total,
+			Completed:      raw.Completed,
+			Pending:        raw.Pending,
+			CompletionRate: completionRate,
+		}
+		if raw.MaxUpdatedAt.After(maxUpdatedAt) {
+			maxUpdatedAt = raw.MaxUpdatedAt
+		}
+	}
+
+	for i := range weekStats {
+		windowStart := i - movingAverageWindow + 1
+		if windowStart < 0 {
+			windowStart = 0
+		}
+		var sum float64
+		for j := windowStart; j <= i; j++ {
+			sum += weekStats[j].CompletionRate
+		}
+		weekStats[i].MovingAvgRate4Week = sum / float64(i-windowStart+1)
+	}
+
+	return &Dashboard{
+		Weeks:         weekStats,
+		CurrentStreak: currentStreak(rawStats),
+		ETag:          etagFromTime(maxUpdatedAt),
+	}, nil
+}
+
+// currentStreak 从最近一周往回数，统计连续"至少完成一个任务"的周数，一旦遇到完成数为 0 的周就停止
+func currentStreak(chronological []repository.WeeklyStat) int {
+	streak := 0
+	for i := len(chronological) - 1; i >= 0; i-- {
+		if chronological[i].Completed == 0 {
+			break
+		}
+		streak++
+	}
+	return streak
+}
+
+// fillMissingWeeks 把 stats(已按时间从旧到新排序)中缺失的日历周补成 Total/Completed/Pending 均为 0 的记录，
+// 使得结果覆盖从最早到最新一周的每一个 ISO 周，不会出现"没有任务的周被跳过"而导致连续周数/移动平均算错的问题
+func fillMissingWeeks(stats []repository.WeeklyStat) ([]repository.WeeklyStat, error) {
+	if len(stats) == 0 {
+		return stats, nil
+	}
+
+	byWeek := make(map[string]repository.WeeklyStat, len(stats))
+	for _, stat := range stats {
+		byWeek[stat.WeekIdentifier] = stat
+	}
+
+	filled := make([]repository.WeeklyStat, 0, len(stats))
+	week := stats[0].WeekIdentifier
+	last := stats[len(stats)-1].WeekIdentifier
+	for {
+		if stat, ok := byWeek[week]; ok {
+			filled = append(filled, stat)
+		} else {
+			filled = append(filled, repository.WeeklyStat{WeekIdentifier: week})
+		}
+		if week == last {
+			break
+		}
+		next, err := ShiftWeekIdentifier(week, 1)
+		if err != nil {
+			return nil, err
+		}
+		week = next
+	}
+	return filled, nil
+}
+
+func reverseWeeklyStats(stats []repository.WeeklyStat) {
+	for i, j := 0, len(stats)-1; i < j; i, j = i+1, j-1 {
+		stats[i], stats[j] = stats[j], stats[i]
+	}
+}
+
+// etagFromTime 把最近一次更新时间编码成弱 ETag，供客户端做条件请求缓存
+func etagFromTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return `W/"` + t.UTC().Format(time.RFC3339Nano) + `"`
+}
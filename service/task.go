@@ -0,0 +1,190 @@
+// Package service 承载任务相关的业务逻辑（周标识符计算、顺延规则等），
+// 对上只暴露给 handler 使用，对下通过 repository.TaskRepository 做持久化。
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Alchemist-xin/weekly-task-tracker/repository"
+)
+
+// TaskService 组合了任务仓储，提供给 handler 层调用的业务方法
+type TaskService struct {
+	repo repository.TaskRepository
+}
+
+// NewTaskService 用一个 TaskRepository 构造任务服务
+func NewTaskService(repo repository.TaskRepository) *TaskService {
+	return &TaskService{repo: repo}
+}
+
+// ---- 周标识符工具函数 ----
+
+// CurrentWeekIdentifier 计算当前时间所在的 ISO 8601 周标识符 (YYYY-WW)
+func CurrentWeekIdentifier() string {
+	return WeekIdentifierForTime(time.Now())
+}
+
+// WeekIdentifierForTime 根据给定时间计算其 ISO 8601 周标识符 (YYYY-WW)
+func WeekIdentifierForTime(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// ParseWeekIdentifier 解析形如 "2025-W07" 的周标识符，返回年份与 ISO 周数
+func ParseWeekIdentifier(identifier string) (year int, week int, err error) {
+	_, err = fmt.Sscanf(identifier, "%d-W%d", &year, &week)
+	if err != nil {
+		return 0, 0, fmt.Errorf("无效的周标识符 %q: %w", identifier, err)
+	}
+	return year, week, nil
+}
+
+// mondayOfISOWeek 返回给定 ISO 年/周的周一零点时间，用于在周标识符与具体日期之间换算
+func mondayOfISOWeek(year, week int) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan4.Weekday()) + 6) % 7 // 将周日(0)映射为6，使周一为0，从而算出 jan4 所在周的周一
+	mondayOfWeek1 := jan4.AddDate(0, 0, -offset)
+	return mondayOfWeek1.AddDate(0, 0, (week-1)*7)
+}
+
+// ShiftWeekIdentifier 将周标识符平移 deltaWeeks 周，deltaWeeks 可为负数表示回退
+func ShiftWeekIdentifier(identifier string, deltaWeeks int) (string, error) {
+	year, week, err := ParseWeekIdentifier(identifier)
+	if err != nil {
+		return "", err
+	}
+	shifted := mondayOfISOWeek(year, week).AddDate(0, 0, deltaWeeks*7)
+	return WeekIdentifierForTime(shifted), nil
+}
+
+// ---- 任务 CRUD ----
+
+func (s *TaskService) CreateTask(ctx context.Context, userID int64, description, recurrenceRule string) (*repository.Task, error) {
+	if recurrenceRule == "" {
+		recurrenceRule = "none"
+	}
+	task := &repository.Task{
+		UserID:         userID,
+		Description:    description,
+		WeekIdentifier: CurrentWeekIdentifier(),
+		Status:         "pending",
+		RecurrenceRule: recurrenceRule,
+	}
+	if err := s.repo.Create(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (s *TaskService) GetTask(ctx context.Context, id, userID int64) (*repository.Task, error) {
+	return s.repo.GetByID(ctx, id, userID)
+}
+
+func (s *TaskService) ListTasksForWeek(ctx context.Context, userID int64, weekIdentifier string) ([]repository.Task, error) {
+	if weekIdentifier == "" {
+		weekIdentifier = CurrentWeekIdentifier()
+	}
+	return s.repo.ListByWeekAndUser(ctx, weekIdentifier, userID)
+}
+
+func (s *TaskService) UpdateTask(ctx context.Context, id, userID int64, description, recurrenceRule string) error {
+	if recurrenceRule == "" {
+		recurrenceRule = "none"
+	}
+	return s.repo.Update(ctx, id, userID, description, recurrenceRule)
+}
+
+func (s *TaskService) UpdateTaskStatus(ctx context.Context, id, userID int64, status string) error {
+	return s.repo.UpdateStatus(ctx, id, userID, status)
+}
+
+func (s *TaskService) DeleteTask(ctx context.Context, id, userID int64) error {
+	return s.repo.Delete(ctx, id, userID)
+}
+
+// ---- 顺延 (carry-over) ----
+
+// PreviewCarryover 返回某个用户在 from 周中会被顺延的候选任务，不做任何写入
+func (s *TaskService) PreviewCarryover(ctx context.Context, userID int64, from string) ([]repository.Task, error) {
+	return s.repo.ListCarryoverCandidates(ctx, from, userID)
+}
+
+// ApplyCarryover 将某个用户在 from 周中符合条件的任务物化为 to 周的新任务，返回新创建的任务数量。
+// 只作用于调用者自己的任务——HTTP 层传入的 userID 必须来自认证主体，不能由调用方随意指定。
+func (s *TaskService) ApplyCarryover(ctx context.Context, userID int64, from, to string) (int64, error) {
+	candidates, err := s.repo.ListCarryoverCandidates(ctx, from, userID)
+	if err != nil {
+		return 0, err
+	}
+	return materializeCarryover(ctx, s.repo, candidates, to)
+}
+
+// StartCarryoverScheduler 是一个阻塞的后台循环，在每次 ISO 周发生切换时，
+// 自动为所有用户将上一周仍为 pending 或带有重复规则的任务物化到新的一周。调用方应以 goroutine 方式启动它。
+func (s *TaskService) StartCarryoverScheduler(ctx context.Context) {
+	currentWeek := CurrentWeekIdentifier()
+	for {
+		time.Sleep(1 * time.Hour)
+		newWeek := CurrentWeekIdentifier()
+		if newWeek == currentWeek {
+			continue
+		}
+
+		candidates, err := s.repo.ListAllCarryoverCandidates(ctx, currentWeek)
+		if err != nil {
+			log.Printf("自动顺延任务失败 (from %s to %s): %v", currentWeek, newWeek, err)
+			currentWeek = newWeek
+			continue
+		}
+
+		created, err := materializeCarryover(ctx, s.repo, candidates, newWeek)
+		if err != nil {
+			log.Printf("自动顺延任务失败 (from %s to %s): %v", currentWeek, newWeek, err)
+		} else {
+			log.Printf("自动顺延完成 (from %s to %s): 新建 %d 个任务", currentWeek, newWeek, created)
+		}
+		currentWeek = newWeek
+	}
+}
+
+// materializeCarryover 把一批候选任务物化为 to 周的新任务，每条新任务保留原任务的 UserID 和 RecurrenceRule，
+// 并通过 ParentTaskID 指回来源任务。物化前会先跳过 to 周中已经存在的顺延子任务(按 ParentTaskID 去重)，
+// 使得重复调用(比如 /tasks/carryover 被重复提交，或与 StartCarryoverScheduler 重叠执行)是幂等的，不会产生重复任务
+func materializeCarryover(ctx context.Context, repo repository.TaskRepository, candidates []repository.Task, to string) (int64, error) {
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	parentIDs := make([]int64, len(candidates))
+	for i, task := range candidates {
+		parentIDs[i] = task.ID
+	}
+	alreadyCarried, err := repo.ExistingCarryoverChildren(ctx, to, parentIDs)
+	if err != nil {
+		return 0, fmt.Errorf("检查 %s 周已有顺延任务失败: %w", to, err)
+	}
+
+	var created int64
+	for _, task := range candidates {
+		if alreadyCarried[task.ID] {
+			continue
+		}
+		newTask := &repository.Task{
+			UserID:         task.UserID,
+			Description:    task.Description,
+			WeekIdentifier: to,
+			Status:         "pending",
+			RecurrenceRule: task.RecurrenceRule,
+			ParentTaskID:   &task.ID,
+		}
+		if err := repo.Create(ctx, newTask); err != nil {
+			return created, fmt.Errorf("顺延任务 %d 到 %s 失败: %w", task.ID, to, err)
+		}
+		created++
+	}
+	return created, nil
+}